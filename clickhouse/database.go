@@ -0,0 +1,555 @@
+package clickhouse
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &databaseResource{}
+	_ resource.ResourceWithConfigure   = &databaseResource{}
+	_ resource.ResourceWithImportState = &databaseResource{}
+)
+
+// NewDatabaseResource is a helper function to simplify the provider implementation.
+func NewDatabaseResource() resource.Resource {
+	return &databaseResource{}
+}
+
+// databaseResource manages a single ClickHouse database via DDL issued against a
+// running service's native/HTTPS endpoint, as opposed to the ClickHouse Cloud
+// control-plane API used by serviceResource.
+type databaseResource struct {
+	client *Client
+}
+
+type databaseConnectionModel struct {
+	Host     types.String `tfsdk:"host"`
+	Port     types.Int64  `tfsdk:"port"`
+	Protocol types.String `tfsdk:"protocol"`
+	Username types.String `tfsdk:"username"`
+	Password types.String `tfsdk:"password"`
+}
+
+type databaseResourceModel struct {
+	ID         types.String             `tfsdk:"id"`
+	ServiceID  types.String             `tfsdk:"service_id"`
+	Password   types.String             `tfsdk:"password"`
+	Connection *databaseConnectionModel `tfsdk:"connection"`
+	Name       types.String             `tfsdk:"name"`
+	Engine     types.String             `tfsdk:"engine"`
+	Comment    types.String             `tfsdk:"comment"`
+	Cluster    types.String             `tfsdk:"cluster"`
+}
+
+// Metadata returns the resource type name.
+func (r *databaseResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_database"
+}
+
+// Schema defines the schema for the resource.
+func (r *databaseResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a database on a running ClickHouse service via SQL DDL, as opposed to " +
+			"`clickhouse_service`, which manages the service itself.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier for the database, in the form `<service_id>/<name>`.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service_id": schema.StringAttribute{
+				Description: "ID of a `clickhouse_service` managed by this provider to connect to. Conflicts with `connection`.",
+				Optional:    true,
+			},
+			"password": schema.StringAttribute{
+				Description: "Password used to connect to the service referenced by `service_id`. Required when `service_id` is set.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"connection": schema.SingleNestedAttribute{
+				Description: "Explicit connection details for a service not managed by this provider. Conflicts with `service_id`.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"host": schema.StringAttribute{
+						Description: "Hostname of the ClickHouse HTTPS endpoint.",
+						Required:    true,
+					},
+					"port": schema.Int64Attribute{
+						Description: "Port of the ClickHouse HTTPS endpoint.",
+						Optional:    true,
+						Computed:    true,
+					},
+					"protocol": schema.StringAttribute{
+						Description: "Endpoint protocol. Only 'https' is currently supported.",
+						Optional:    true,
+						Computed:    true,
+					},
+					"username": schema.StringAttribute{
+						Description: "User to connect as.",
+						Optional:    true,
+						Computed:    true,
+					},
+					"password": schema.StringAttribute{
+						Description: "Password for the connecting user.",
+						Required:    true,
+						Sensitive:   true,
+					},
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the database.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					identifierValidator{},
+				},
+			},
+			"engine": schema.StringAttribute{
+				Description: "Database engine, e.g. 'Atomic' or 'Replicated'. Defaults to 'Atomic'.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					identifierValidator{},
+				},
+			},
+			"comment": schema.StringAttribute{
+				Description: "Comment attached to the database.",
+				Optional:    true,
+			},
+			"cluster": schema.StringAttribute{
+				Description: "Cluster to run the DDL `ON CLUSTER` of. Leave unset for non-clustered services.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					identifierValidator{},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *databaseResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*Client)
+}
+
+// ConfigValidators enforces that exactly one of service_id or connection is configured.
+func (r *databaseResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validatorExactlyOneOfServiceOrConnection{},
+	}
+}
+
+// resolveDatabaseConnection returns the endpoint and credentials to connect with,
+// either by looking up service_id's HTTPS endpoint or using the explicit connection block.
+func resolveDatabaseConnection(ctx context.Context, client *Client, plan databaseResourceModel) (chConnection, error) {
+	if !plan.ServiceID.IsNull() && plan.ServiceID.ValueString() != "" {
+		service, err := client.GetService(plan.ServiceID.ValueString())
+		if err != nil {
+			return chConnection{}, fmt.Errorf("could not look up service %q: %w", plan.ServiceID.ValueString(), err)
+		}
+
+		var endpoint *Endpoint
+		for i := range service.Endpoints {
+			if service.Endpoints[i].Protocol == "https" {
+				endpoint = &service.Endpoints[i]
+				break
+			}
+		}
+		if endpoint == nil {
+			return chConnection{}, fmt.Errorf("service %q does not expose an https endpoint", plan.ServiceID.ValueString())
+		}
+
+		return chConnection{
+			Host:     endpoint.Host,
+			Port:     endpoint.Port,
+			Username: "default",
+			Password: plan.Password.ValueString(),
+		}, nil
+	}
+
+	conn := plan.Connection
+	if conn == nil {
+		return chConnection{}, errors.New("exactly one of service_id or connection must be set")
+	}
+
+	port := int(conn.Port.ValueInt64())
+	if port == 0 {
+		port = 8443
+	}
+	username := conn.Username.ValueString()
+	if username == "" {
+		username = "default"
+	}
+
+	return chConnection{
+		Host:     conn.Host.ValueString(),
+		Port:     port,
+		Username: username,
+		Password: conn.Password.ValueString(),
+	}, nil
+}
+
+// chConnection is a resolved set of details for connecting to a service's HTTPS endpoint.
+type chConnection struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+}
+
+var httpClient = &http.Client{
+	Timeout: 30 * time.Second,
+	Transport: &http.Transport{
+		TLSClientConfig: &tls.Config{MinVersion: tls.VersionTLS12},
+	},
+}
+
+// execDDL issues a single DDL statement over the ClickHouse HTTPS interface.
+func execDDL(ctx context.Context, conn chConnection, query string) error {
+	url := fmt.Sprintf("https://%s:%d/", conn.Host, conn.Port)
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, url, strings.NewReader(query))
+	if err != nil {
+		return err
+	}
+	httpReq.SetBasicAuth(conn.Username, conn.Password)
+
+	resp, err := httpClient.Do(httpReq)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("clickhouse returned HTTP %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	return nil
+}
+
+// databaseRow is the shape of a single row returned from system.databases in JSON format.
+type databaseRow struct {
+	Engine  string `json:"engine"`
+	Comment string `json:"comment"`
+}
+
+// queryDatabase reads engine and comment back from system.databases for drift detection.
+func queryDatabase(ctx context.Context, conn chConnection, name string) (*databaseRow, error) {
+	url := fmt.Sprintf("https://%s:%d/", conn.Host, conn.Port)
+	query := fmt.Sprintf("SELECT engine, comment FROM system.databases WHERE name = '%s' FORMAT JSON", escapeSQLString(name))
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, url, strings.NewReader(query))
+	if err != nil {
+		return nil, err
+	}
+	httpReq.SetBasicAuth(conn.Username, conn.Password)
+
+	resp, err := httpClient.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("clickhouse returned HTTP %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	var result struct {
+		Data []databaseRow `json:"data"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("could not parse system.databases response: %w", err)
+	}
+	if len(result.Data) == 0 {
+		return nil, nil
+	}
+
+	return &result.Data[0], nil
+}
+
+// escapeSQLString escapes s for use inside a single-quoted ClickHouse string literal.
+// Backslashes must be escaped before quotes: otherwise a value ending in a backslash
+// (e.g. `foo\`) would have its escaped quote (`foo\'`) read by ClickHouse as an escaped
+// backslash followed by a real closing quote, letting the rest of the string execute as SQL.
+func escapeSQLString(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, "'", `\'`)
+	return s
+}
+
+// identifierPattern matches the identifiers this provider is willing to splice
+// unescaped into DDL: ASCII letters, digits and underscores, not starting with a digit.
+var identifierPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// identifierValidator rejects schema values that aren't safe to use as a ClickHouse
+// identifier. name, cluster and engine are all spliced into DDL; this is cheaper and
+// safer than trying to escape an arbitrary identifier.
+type identifierValidator struct{}
+
+func (v identifierValidator) Description(_ context.Context) string {
+	return "must be a valid ClickHouse identifier: letters, digits and underscores only, not starting with a digit"
+}
+
+func (v identifierValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v identifierValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if !identifierPattern.MatchString(req.ConfigValue.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("%s must be a valid ClickHouse identifier (letters, digits and underscores, not starting with a digit), got: %q.", req.Path, req.ConfigValue.ValueString()),
+		)
+	}
+}
+
+// quoteIdentifier backtick-quotes an identifier already accepted by identifierValidator.
+func quoteIdentifier(s string) string {
+	return "`" + s + "`"
+}
+
+// Create creates a new database on the target service.
+func (r *databaseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan databaseResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn, err := resolveDatabaseConnection(ctx, r.client, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Error resolving database connection", err.Error())
+		return
+	}
+
+	engine := plan.Engine.ValueString()
+	if engine == "" {
+		engine = "Atomic"
+	}
+
+	var query strings.Builder
+	query.WriteString(fmt.Sprintf("CREATE DATABASE %s", quoteIdentifier(plan.Name.ValueString())))
+	if cluster := plan.Cluster.ValueString(); cluster != "" {
+		query.WriteString(fmt.Sprintf(" ON CLUSTER %s", quoteIdentifier(cluster)))
+	}
+	query.WriteString(fmt.Sprintf(" ENGINE = %s", engine))
+	if comment := plan.Comment.ValueString(); comment != "" {
+		query.WriteString(fmt.Sprintf(" COMMENT '%s'", escapeSQLString(comment)))
+	}
+
+	if err := execDDL(ctx, conn, query.String()); err != nil {
+		resp.Diagnostics.AddError("Error creating database", "Could not create database, unexpected error: "+err.Error())
+		return
+	}
+
+	plan.Engine = types.StringValue(engine)
+	plan.ID = types.StringValue(fmt.Sprintf("%s/%s", plan.ServiceID.ValueString(), plan.Name.ValueString()))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *databaseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state databaseResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn, err := resolveDatabaseConnection(ctx, r.client, state)
+	if err != nil {
+		resp.Diagnostics.AddError("Error resolving database connection", err.Error())
+		return
+	}
+
+	row, err := queryDatabase(ctx, conn, state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading database", "Could not read database, unexpected error: "+err.Error())
+		return
+	}
+	if row == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.Engine = types.StringValue(row.Engine)
+	// ClickHouse reports comment as "" rather than null for a database with none set.
+	// Only promote that into state.Comment when the user has actually configured one
+	// (or the prior state already reflects a comment); otherwise leaving comment unset
+	// in config would never match state and every plan would issue a no-op ALTER.
+	if row.Comment != "" || !state.Comment.IsNull() {
+		state.Comment = types.StringValue(row.Comment)
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update only applies comment changes, via ALTER DATABASE: name, engine and
+// cluster all require replacement.
+func (r *databaseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state databaseResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn, err := resolveDatabaseConnection(ctx, r.client, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Error resolving database connection", err.Error())
+		return
+	}
+
+	if plan.Comment != state.Comment {
+		query := fmt.Sprintf("ALTER DATABASE %s MODIFY COMMENT '%s'", quoteIdentifier(plan.Name.ValueString()), escapeSQLString(plan.Comment.ValueString()))
+		if err := execDDL(ctx, conn, query); err != nil {
+			resp.Diagnostics.AddError("Error updating database", "Could not update database comment, unexpected error: "+err.Error())
+			return
+		}
+	}
+
+	plan.ID = state.ID
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete drops the database from the target service.
+func (r *databaseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state databaseResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn, err := resolveDatabaseConnection(ctx, r.client, state)
+	if err != nil {
+		resp.Diagnostics.AddError("Error resolving database connection", err.Error())
+		return
+	}
+
+	query := fmt.Sprintf("DROP DATABASE IF EXISTS %s", quoteIdentifier(state.Name.ValueString()))
+	if cluster := state.Cluster.ValueString(); cluster != "" {
+		query += fmt.Sprintf(" ON CLUSTER %s", quoteIdentifier(cluster))
+	}
+
+	if err := execDDL(ctx, conn, query); err != nil {
+		resp.Diagnostics.AddError("Error deleting database", "Could not delete database, unexpected error: "+err.Error())
+		return
+	}
+}
+
+// ImportState imports a database given an ID in the form `<service_id>/<name>`, the same
+// form produced by Create. Databases addressed via an explicit `connection` block instead
+// of `service_id` cannot be imported, since the connection's host/port/credentials can't
+// be recovered from the ID alone.
+func (r *databaseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idx := strings.LastIndex(req.ID, "/")
+	if idx <= 0 || idx == len(req.ID)-1 {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import ID in the form '<service_id>/<name>', got: %q. "+
+				"Databases addressed via an explicit 'connection' block cannot be imported.", req.ID),
+		)
+		return
+	}
+
+	serviceID := req.ID[:idx]
+	name := req.ID[idx+1:]
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service_id"), serviceID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+}
+
+// validatorExactlyOneOfServiceOrConnection enforces that a database is configured
+// to connect either via a managed service_id or an explicit connection block, not both.
+type validatorExactlyOneOfServiceOrConnection struct{}
+
+func (v validatorExactlyOneOfServiceOrConnection) Description(_ context.Context) string {
+	return "exactly one of service_id or connection must be set"
+}
+
+func (v validatorExactlyOneOfServiceOrConnection) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v validatorExactlyOneOfServiceOrConnection) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config databaseResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hasServiceID := !config.ServiceID.IsNull() && config.ServiceID.ValueString() != ""
+	hasConnection := config.Connection != nil
+
+	if hasServiceID == hasConnection {
+		resp.Diagnostics.AddError(
+			"Invalid configuration",
+			"Exactly one of 'service_id' or 'connection' must be set on a clickhouse_database resource.",
+		)
+		return
+	}
+
+	// password is only meaningful alongside service_id; skip the check if either value
+	// won't be known until apply (e.g. service_id comes from another resource).
+	if hasServiceID && !config.ServiceID.IsUnknown() && !config.Password.IsUnknown() {
+		hasPassword := !config.Password.IsNull() && config.Password.ValueString() != ""
+		if !hasPassword {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("password"),
+				"Missing Password",
+				"'password' must be set when 'service_id' is used to connect to a database.",
+			)
+		}
+	}
+}
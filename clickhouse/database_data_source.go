@@ -0,0 +1,188 @@
+package clickhouse
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                     = &databaseDataSource{}
+	_ datasource.DataSourceWithConfigure        = &databaseDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &databaseDataSource{}
+)
+
+// NewDatabaseDataSource is a helper function to simplify the provider implementation.
+func NewDatabaseDataSource() datasource.DataSource {
+	return &databaseDataSource{}
+}
+
+// databaseDataSource reads an existing database's metadata from a running service.
+type databaseDataSource struct {
+	client *Client
+}
+
+type databaseDataSourceModel struct {
+	ID         types.String             `tfsdk:"id"`
+	ServiceID  types.String             `tfsdk:"service_id"`
+	Password   types.String             `tfsdk:"password"`
+	Connection *databaseConnectionModel `tfsdk:"connection"`
+	Name       types.String             `tfsdk:"name"`
+	Engine     types.String             `tfsdk:"engine"`
+	Comment    types.String             `tfsdk:"comment"`
+}
+
+func (d *databaseDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_database"
+}
+
+func (d *databaseDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads metadata about an existing database on a running ClickHouse service.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier for the database, in the form `<service_id>/<name>`.",
+				Computed:    true,
+			},
+			"service_id": schema.StringAttribute{
+				Description: "ID of a `clickhouse_service` managed by this provider to connect to. Conflicts with `connection`.",
+				Optional:    true,
+			},
+			"password": schema.StringAttribute{
+				Description: "Password used to connect to the service referenced by `service_id`. Required when `service_id` is set.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"connection": schema.SingleNestedAttribute{
+				Description: "Explicit connection details for a service not managed by this provider. Conflicts with `service_id`.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"host": schema.StringAttribute{
+						Description: "Hostname of the ClickHouse HTTPS endpoint.",
+						Required:    true,
+					},
+					"port": schema.Int64Attribute{
+						Description: "Port of the ClickHouse HTTPS endpoint.",
+						Optional:    true,
+						Computed:    true,
+					},
+					"protocol": schema.StringAttribute{
+						Description: "Endpoint protocol. Only 'https' is currently supported.",
+						Optional:    true,
+						Computed:    true,
+					},
+					"username": schema.StringAttribute{
+						Description: "User to connect as.",
+						Optional:    true,
+						Computed:    true,
+					},
+					"password": schema.StringAttribute{
+						Description: "Password for the connecting user.",
+						Required:    true,
+						Sensitive:   true,
+					},
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the database.",
+				Required:    true,
+			},
+			"engine": schema.StringAttribute{
+				Description: "Database engine, e.g. 'Atomic' or 'Replicated'.",
+				Computed:    true,
+			},
+			"comment": schema.StringAttribute{
+				Description: "Comment attached to the database.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *databaseDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*Client)
+}
+
+// ConfigValidators enforces that a database is looked up either via a managed
+// service_id or an explicit connection block, not both.
+func (d *databaseDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		validatorDatabaseDataSourceExactlyOneOf{},
+	}
+}
+
+// validatorDatabaseDataSourceExactlyOneOf mirrors validatorExactlyOneOfServiceOrConnection
+// from database.go, but for the clickhouse_database data source's own config model.
+type validatorDatabaseDataSourceExactlyOneOf struct{}
+
+func (v validatorDatabaseDataSourceExactlyOneOf) Description(_ context.Context) string {
+	return "exactly one of service_id or connection must be set"
+}
+
+func (v validatorDatabaseDataSourceExactlyOneOf) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v validatorDatabaseDataSourceExactlyOneOf) ValidateDataSource(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config databaseDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hasServiceID := !config.ServiceID.IsNull() && config.ServiceID.ValueString() != ""
+	hasConnection := config.Connection != nil
+
+	if hasServiceID == hasConnection {
+		resp.Diagnostics.AddError(
+			"Invalid configuration",
+			"Exactly one of 'service_id' or 'connection' must be set on a clickhouse_database data source.",
+		)
+	}
+}
+
+func (d *databaseDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config databaseDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn, err := resolveDatabaseConnection(ctx, d.client, databaseResourceModel{
+		ServiceID:  config.ServiceID,
+		Password:   config.Password,
+		Connection: config.Connection,
+		Name:       config.Name,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error resolving database connection", err.Error())
+		return
+	}
+
+	row, err := queryDatabase(ctx, conn, config.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading database", "Could not read database, unexpected error: "+err.Error())
+		return
+	}
+	if row == nil {
+		resp.Diagnostics.AddError("Database not found", fmt.Sprintf("No database named %q was found on the target service.", config.Name.ValueString()))
+		return
+	}
+
+	config.Engine = types.StringValue(row.Engine)
+	config.Comment = types.StringValue(row.Comment)
+	config.ID = types.StringValue(fmt.Sprintf("%s/%s", config.ServiceID.ValueString(), config.Name.ValueString()))
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
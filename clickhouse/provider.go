@@ -0,0 +1,129 @@
+package clickhouse
+
+import (
+	"context"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ provider.Provider = &clickhouseProvider{}
+
+// New is a helper function to simplify provider server and testing implementation.
+func New() provider.Provider {
+	return &clickhouseProvider{}
+}
+
+// clickhouseProvider is the top-level implementation of the ClickHouse Cloud provider.
+type clickhouseProvider struct{}
+
+// clickhouseProviderModel describes the provider-level configuration.
+type clickhouseProviderModel struct {
+	OrganizationID types.String `tfsdk:"organization_id"`
+	KeyID          types.String `tfsdk:"key_id"`
+	KeySecret      types.String `tfsdk:"key_secret"`
+}
+
+func (p *clickhouseProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "clickhouse"
+}
+
+func (p *clickhouseProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Interacts with the ClickHouse Cloud API to manage services, and with running services over SQL to manage databases.",
+		Attributes: map[string]schema.Attribute{
+			"organization_id": schema.StringAttribute{
+				Description: "ClickHouse Cloud organization ID. Defaults to the CLICKHOUSE_ORGANIZATION_ID environment variable.",
+				Optional:    true,
+			},
+			"key_id": schema.StringAttribute{
+				Description: "ClickHouse Cloud API key ID. Defaults to the CLICKHOUSE_KEY_ID environment variable.",
+				Optional:    true,
+			},
+			"key_secret": schema.StringAttribute{
+				Description: "ClickHouse Cloud API key secret. Defaults to the CLICKHOUSE_KEY_SECRET environment variable.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+// Configure builds the Client used by every resource and data source in this provider
+// and hands it off via resp.ResourceData / resp.DataSourceData, mirroring the
+// req.ProviderData.(*Client) assertion each resource and data source's own Configure expects.
+func (p *clickhouseProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	var config clickhouseProviderModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organizationID := config.OrganizationID.ValueString()
+	if organizationID == "" {
+		organizationID = os.Getenv("CLICKHOUSE_ORGANIZATION_ID")
+	}
+
+	keyID := config.KeyID.ValueString()
+	if keyID == "" {
+		keyID = os.Getenv("CLICKHOUSE_KEY_ID")
+	}
+
+	keySecret := config.KeySecret.ValueString()
+	if keySecret == "" {
+		keySecret = os.Getenv("CLICKHOUSE_KEY_SECRET")
+	}
+
+	if organizationID == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("organization_id"),
+			"Missing ClickHouse Cloud Organization ID",
+			"The provider cannot create the ClickHouse Cloud API client: 'organization_id' is not set and "+
+				"CLICKHOUSE_ORGANIZATION_ID is not set in the environment.",
+		)
+	}
+	if keyID == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("key_id"),
+			"Missing ClickHouse Cloud API Key ID",
+			"The provider cannot create the ClickHouse Cloud API client: 'key_id' is not set and "+
+				"CLICKHOUSE_KEY_ID is not set in the environment.",
+		)
+	}
+	if keySecret == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("key_secret"),
+			"Missing ClickHouse Cloud API Key Secret",
+			"The provider cannot create the ClickHouse Cloud API client: 'key_secret' is not set and "+
+				"CLICKHOUSE_KEY_SECRET is not set in the environment.",
+		)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := NewClient(organizationID, keyID, keySecret)
+	resp.ResourceData = client
+	resp.DataSourceData = client
+}
+
+func (p *clickhouseProvider) Resources(_ context.Context) []func() resource.Resource {
+	return []func() resource.Resource{
+		NewServiceResource,
+		NewDatabaseResource,
+	}
+}
+
+func (p *clickhouseProvider) DataSources(_ context.Context) []func() datasource.DataSource {
+	return []func() datasource.DataSource{
+		NewServiceDataSource,
+		NewDatabaseDataSource,
+	}
+}
@@ -0,0 +1,84 @@
+package clickhouse
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"net"
+	"strings"
+	"time"
+)
+
+// Defaults for withRetry. These mirror the retry helpers found in mature Terraform
+// providers: a handful of attempts, exponential backoff, capped at 30s, with full
+// jitter so that concurrent operations (e.g. several resources in the same apply)
+// don't all retry in lockstep.
+const (
+	retryBaseDelay   = 1 * time.Second
+	retryMaxDelay    = 30 * time.Second
+	retryMaxAttempts = 5
+)
+
+// isRetryable reports whether err looks like a transient failure worth retrying:
+// HTTP 429/5xx responses as surfaced by the Cloud API client, network timeouts,
+// and connection resets. A context deadline actually expiring is terminal.
+func isRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return netErr.Timeout()
+	}
+
+	msg := err.Error()
+	for _, marker := range []string{"429", "500", "502", "503", "504", "connection reset", "EOF"} {
+		if strings.Contains(msg, marker) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// backoff returns the delay before the given retry attempt (0-indexed), using
+// exponential backoff with full jitter: sleep = random(0, min(cap, base*2^attempt)).
+func backoff(attempt int) time.Duration {
+	capDelay := float64(retryMaxDelay)
+	exp := float64(retryBaseDelay) * math.Pow(2, float64(attempt))
+	d := math.Min(capDelay, exp)
+	return time.Duration(rand.Float64() * d)
+}
+
+// withRetry calls fn, retrying on transient errors with exponential backoff and
+// full jitter up to retryMaxAttempts times. It respects ctx cancellation between
+// attempts and annotates the final error with the number of attempts made.
+func withRetry(ctx context.Context, fn func() error) error {
+	var lastErr error
+	for attempt := 0; attempt < retryMaxAttempts; attempt++ {
+		lastErr = fn()
+		if lastErr == nil {
+			return nil
+		}
+		if !isRetryable(lastErr) {
+			return lastErr
+		}
+		if attempt == retryMaxAttempts-1 {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("retry aborted after %d attempt(s): %w", attempt+1, ctx.Err())
+		case <-time.After(backoff(attempt)):
+		}
+	}
+
+	return fmt.Errorf("gave up after %d attempts: %w", retryMaxAttempts, lastErr)
+}
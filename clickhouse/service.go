@@ -2,21 +2,85 @@ package clickhouse
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+// defaultServiceOperationTimeout bounds Create/Update/Delete when the user has not
+// configured an explicit timeouts block. ClickHouse Cloud provisioning and scaling
+// operations normally complete in a few minutes; 30 minutes leaves generous headroom.
+const defaultServiceOperationTimeout = 30 * time.Minute
+
+// servicePollInterval is how often the provider polls the Cloud API while waiting
+// for a long-running operation (provisioning, scaling, deprovisioning) to finish.
+const servicePollInterval = 5 * time.Second
+
+// waitForServiceState polls the Cloud API until the service's state is no longer
+// one of the given transient states, or until ctx is done.
+func waitForServiceState(ctx context.Context, client *Client, serviceId string, transient ...string) (*Service, error) {
+	for {
+		var s *Service
+		err := withRetry(ctx, func() error {
+			var err error
+			s, err = client.GetService(serviceId)
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		stillTransient := false
+		for _, t := range transient {
+			if s.State == t {
+				stillTransient = true
+				break
+			}
+		}
+		if !stillTransient {
+			return s, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(servicePollInterval):
+		}
+	}
+}
+
+// privateEndpointConfigFromService maps the private endpoint details returned by the
+// Cloud API onto the computed private_endpoint_config block, or nil if the service
+// has no private endpoints attached.
+func privateEndpointConfigFromService(s *Service) *PrivateEndpointConfigModel {
+	if s.PrivateEndpointConfig == nil {
+		return nil
+	}
+
+	return &PrivateEndpointConfigModel{
+		EndpointServiceId:  types.StringValue(s.PrivateEndpointConfig.EndpointServiceId),
+		PrivateDnsHostname: types.StringValue(s.PrivateEndpointConfig.PrivateDnsHostname),
+	}
+}
+
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &serviceResource{}
-	_ resource.ResourceWithConfigure   = &serviceResource{}
-	_ resource.ResourceWithImportState = &serviceResource{}
+	_ resource.Resource                     = &serviceResource{}
+	_ resource.ResourceWithConfigure        = &serviceResource{}
+	_ resource.ResourceWithImportState      = &serviceResource{}
+	_ resource.ResourceWithConfigValidators = &serviceResource{}
 )
 
 // NewServiceResource is a helper function to simplify the provider implementation.
@@ -30,19 +94,22 @@ type serviceResource struct {
 }
 
 type serviceResourceModel struct {
-	ID                 types.String    `tfsdk:"id"`
-	Name               types.String    `tfsdk:"name"`
-	Password           types.String    `tfsdk:"password"`
-	Endpoints          types.List      `tfsdk:"endpoints"`
-	CloudProvider      types.String    `tfsdk:"cloud_provider"`
-	Region             types.String    `tfsdk:"region"`
-	Tier               types.String    `tfsdk:"tier"`
-	IdleScaling        types.Bool      `tfsdk:"idle_scaling"`
-	IpAccessList       []IpAccessModel `tfsdk:"ip_access"`
-	MinTotalMemoryGb   types.Int64     `tfsdk:"min_total_memory_gb"`
-	MaxTotalMemoryGb   types.Int64     `tfsdk:"max_total_memory_gb"`
-	IdleTimeoutMinutes types.Int64     `tfsdk:"idle_timeout_minutes"`
-	LastUpdated        types.String    `tfsdk:"last_updated"`
+	ID                    types.String                `tfsdk:"id"`
+	Name                  types.String                `tfsdk:"name"`
+	Password              types.String                `tfsdk:"password"`
+	Endpoints             types.List                  `tfsdk:"endpoints"`
+	CloudProvider         types.String                `tfsdk:"cloud_provider"`
+	Region                types.String                `tfsdk:"region"`
+	Tier                  types.String                `tfsdk:"tier"`
+	IdleScaling           types.Bool                  `tfsdk:"idle_scaling"`
+	IpAccessList          []IpAccessModel             `tfsdk:"ip_access"`
+	MinTotalMemoryGb      types.Int64                 `tfsdk:"min_total_memory_gb"`
+	MaxTotalMemoryGb      types.Int64                 `tfsdk:"max_total_memory_gb"`
+	IdleTimeoutMinutes    types.Int64                 `tfsdk:"idle_timeout_minutes"`
+	PrivateEndpointIds    types.List                  `tfsdk:"private_endpoint_ids"`
+	PrivateEndpointConfig *PrivateEndpointConfigModel `tfsdk:"private_endpoint_config"`
+	LastUpdated           types.String                `tfsdk:"last_updated"`
+	Timeouts              timeouts.Value              `tfsdk:"timeouts"`
 }
 
 var endpointObjectType = types.ObjectType{
@@ -58,6 +125,14 @@ type IpAccessModel struct {
 	Description types.String `tfsdk:"description"`
 }
 
+// PrivateEndpointConfigModel surfaces the identifiers a user needs to finish wiring up
+// an AWS PrivateLink VPC endpoint or a GCP Private Service Connect endpoint on their side,
+// once private_endpoint_ids has attached one or more endpoints to the service.
+type PrivateEndpointConfigModel struct {
+	EndpointServiceId  types.String `tfsdk:"endpoint_service_id"`
+	PrivateDnsHostname types.String `tfsdk:"private_dns_hostname"`
+}
+
 // Metadata returns the resource type name.
 func (r *serviceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_service"
@@ -87,16 +162,36 @@ func (r *serviceResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				Sensitive:   true,
 			},
 			"cloud_provider": schema.StringAttribute{
-				Description: "Cloud provider ('aws' or 'gcp') in which the service is deployed in.",
+				Description: "Cloud provider ('aws', 'gcp' or 'azure') in which the service is deployed in. Changing this forces a new service to be created.",
 				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("aws", "gcp", "azure"),
+				},
 			},
 			"region": schema.StringAttribute{
-				Description: "Region within the cloud provider in which the service is deployed in.",
+				Description: "Region within the cloud provider in which the service is deployed in. Changing this forces a new service to be created.",
 				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"tier": schema.StringAttribute{
-				Description: "Tier of the service: 'development', 'production'. Production services scale, Development are fixed size.",
-				Required:    true,
+				Description: "Tier of the service: 'development', 'production'. Production services scale, Development are fixed size. " +
+					"Changing this forces a new service to be created, except for an upgrade from 'development' to 'production'.",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						tierRequiresReplace,
+						"Replaces the service on any tier change other than an upgrade from 'development' to 'production'.",
+						"Replaces the service on any tier change other than an upgrade from 'development' to 'production'.",
+					),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("development", "production"),
+				},
 			},
 			"idle_scaling": schema.BoolAttribute{
 				Description: "When set to true the service is allowed to scale down to zero when idle. Always true for development services.",
@@ -139,17 +234,57 @@ func (r *serviceResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				},
 			},
 			"min_total_memory_gb": schema.Int64Attribute{
-				Description: "Minimum total memory of all workers during auto-scaling in Gb. Available only for 'production' services. Must be a multiple of 12 and greater than 24.",
-				Required:    true,
+				Description: "Minimum total memory of all workers during auto-scaling in Gb. Required for 'production' services, must be unset for 'development' services. Must be a multiple of 12 and at least 24.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(24),
+					multipleOfValidator{multiple: 12},
+				},
 			},
 			"max_total_memory_gb": schema.Int64Attribute{
-				Description: "Maximum total memory of all workers during auto-scaling in Gb. Available only for 'production' services. Must be a multiple of 12 and lower than 360 for non paid services or 720 for paid services.",
-				Required:    true,
+				Description: "Maximum total memory of all workers during auto-scaling in Gb. Required for 'production' services, must be unset for 'development' services. Must be a multiple of 12 and at most 360 for non paid services or 720 for paid services.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtMost(720),
+					multipleOfValidator{multiple: 12},
+				},
 			},
 			"idle_timeout_minutes": schema.Int64Attribute{
 				Description: "Set minimum idling timeout (in minutes). Must be greater than or equal to 5 minutes.",
 				Required:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(5),
+				},
+			},
+			"private_endpoint_ids": schema.ListAttribute{
+				Description: "IDs of AWS PrivateLink or GCP Private Service Connect endpoints to attach to the service, " +
+					"so that it can be reached without traversing the public internet. Not available for 'development' tier services.",
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
 			},
+			"private_endpoint_config": schema.SingleNestedAttribute{
+				Description: "Identifiers needed to finish configuring the private endpoint on the cloud provider side, once at least one ID is set in `private_endpoint_ids`.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"endpoint_service_id": schema.StringAttribute{
+						Description: "The AWS VPC endpoint service name or GCP Private Service Connect target to connect to from the consumer's VPC.",
+						Computed:    true,
+					},
+					"private_dns_hostname": schema.StringAttribute{
+						Description: "Private DNS hostname that resolves to the service over the private endpoint once it is accepted.",
+						Computed:    true,
+					},
+				},
+			},
+			"timeouts": timeouts.Attributes(context.Background(), timeouts.Opts{
+				Create:            true,
+				CreateDescription: "Timeout for creating the service and waiting for it to leave the 'provisioning' state. Defaults to 30m.",
+				Update:            true,
+				UpdateDescription: "Timeout for applying an update and waiting for it to take effect. Defaults to 30m.",
+				Delete:            true,
+				DeleteDescription: "Timeout for deleting the service and waiting for it to be removed. Defaults to 30m.",
+			}),
 		},
 	}
 }
@@ -163,6 +298,138 @@ func (r *serviceResource) Configure(_ context.Context, req resource.ConfigureReq
 	r.client = req.ProviderData.(*Client)
 }
 
+// ConfigValidators enforces cross-field invariants that a single attribute's own
+// Validators can't express.
+func (r *serviceResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validatorPrivateEndpointSupported{},
+		validatorMemoryBoundsForTier{},
+	}
+}
+
+// tierRequiresReplace implements stringplanmodifier.RequiresReplaceIfFunc. ClickHouse
+// Cloud supports upgrading a service from 'development' to 'production' in place;
+// any other tier change (including downgrading) requires a new service.
+func tierRequiresReplace(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = !(req.StateValue.ValueString() == "development" && req.PlanValue.ValueString() == "production")
+}
+
+// multipleOfValidator rejects values that don't land on ClickHouse Cloud's memory
+// allocation granularity.
+type multipleOfValidator struct {
+	multiple int64
+}
+
+func (v multipleOfValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be a multiple of %d", v.multiple)
+}
+
+func (v multipleOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v multipleOfValidator) ValidateInt64(_ context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if req.ConfigValue.ValueInt64()%v.multiple != 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("%s must be a multiple of %d, got: %d.", req.Path, v.multiple, req.ConfigValue.ValueInt64()),
+		)
+	}
+}
+
+// validatorMemoryBoundsForTier enforces that min_total_memory_gb and max_total_memory_gb,
+// which configure auto-scaling bounds, are set for 'production' services and unset for
+// 'development' services, which are fixed size and reject them.
+type validatorMemoryBoundsForTier struct{}
+
+func (v validatorMemoryBoundsForTier) Description(_ context.Context) string {
+	return "min_total_memory_gb and max_total_memory_gb are required for 'production' tier services and must be unset for 'development' tier services"
+}
+
+func (v validatorMemoryBoundsForTier) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v validatorMemoryBoundsForTier) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config serviceResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hasMin := !config.MinTotalMemoryGb.IsNull()
+	hasMax := !config.MaxTotalMemoryGb.IsNull()
+
+	switch config.Tier.ValueString() {
+	case "development":
+		if hasMin || hasMax {
+			resp.Diagnostics.AddError(
+				"Invalid configuration",
+				"min_total_memory_gb and max_total_memory_gb are not supported for 'development' tier services.",
+			)
+		}
+	case "production":
+		if !hasMin || !hasMax {
+			resp.Diagnostics.AddError(
+				"Invalid configuration",
+				"min_total_memory_gb and max_total_memory_gb are required for 'production' tier services.",
+			)
+		}
+	}
+}
+
+// validatorPrivateEndpointSupported rejects private_endpoint_ids on services that
+// can't support it: development tier has no private networking, and Azure does not
+// yet offer the equivalent of AWS PrivateLink / GCP Private Service Connect here.
+type validatorPrivateEndpointSupported struct{}
+
+func (v validatorPrivateEndpointSupported) Description(_ context.Context) string {
+	return "private_endpoint_ids requires a production-tier service on aws or gcp"
+}
+
+func (v validatorPrivateEndpointSupported) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v validatorPrivateEndpointSupported) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config serviceResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.PrivateEndpointIds.IsNull() || config.PrivateEndpointIds.IsUnknown() || len(config.PrivateEndpointIds.Elements()) == 0 {
+		return
+	}
+
+	if !config.Tier.IsUnknown() && config.Tier.ValueString() == "development" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("private_endpoint_ids"),
+			"Invalid configuration",
+			"private_endpoint_ids is not supported for 'development' tier services.",
+		)
+	}
+
+	if !config.CloudProvider.IsUnknown() {
+		switch config.CloudProvider.ValueString() {
+		case "aws", "gcp":
+		default:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("private_endpoint_ids"),
+				"Invalid configuration",
+				"private_endpoint_ids is only supported for 'aws' (PrivateLink) and 'gcp' (Private Service Connect) services.",
+			)
+		}
+	}
+}
+
 // Create a new resource
 func (r *serviceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
@@ -191,7 +458,18 @@ func (r *serviceResource) Create(ctx context.Context, req resource.CreateRequest
 		})
 	}
 
-	// Create new service
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultServiceOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Create new service. Unlike the rest of this file, this call is deliberately not
+	// wrapped in withRetry: CreateService is not idempotent, and retrying it after a
+	// timeout or 5xx whose response was merely lost risks provisioning a duplicate,
+	// orphaned service. A failed create should surface to the user instead.
 	s, password, err := r.client.CreateService(service)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -201,30 +479,50 @@ func (r *serviceResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
-	for {
-		s, err = r.client.GetService(s.Id)
+	serviceId := s.Id
+	s, err = waitForServiceState(ctx, r.client, serviceId, "provisioning")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Timed out waiting for service to provision",
+			fmt.Sprintf("Service %s did not leave the 'provisioning' state within the configured create timeout: %s", serviceId, err.Error()),
+		)
+		return
+	}
+
+	// Update service password if provided explicitly
+	if password = plan.Password.ValueString(); len(password) > 0 {
+		err := withRetry(ctx, func() error {
+			_, err := r.client.UpdateServicePassword(s.Id, ServicePasswordUpdateFromPlainPassword(password))
+			return err
+		})
 		if err != nil {
 			resp.Diagnostics.AddError(
-				"Error retrieving service state",
-				"Could not retrieve service state after creation, unexpected error: "+err.Error(),
+				"Error setting service password",
+				"Could not set service password after creation, unexpected error: "+err.Error(),
 			)
 			return
 		}
+	}
 
-		if s.State != "provisioning" {
-			break
+	// Attach private endpoints if requested. These can also be attached later via
+	// Update, but doing it here avoids a spurious diff on the very first plan.
+	if !plan.PrivateEndpointIds.IsNull() && !plan.PrivateEndpointIds.IsUnknown() && len(plan.PrivateEndpointIds.Elements()) > 0 {
+		var ids []string
+		diags = plan.PrivateEndpointIds.ElementsAs(ctx, &ids, false)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
 
-		time.Sleep(time.Second * 5)
-	}
-
-	// Update service password if provided explicitly
-	if password = plan.Password.ValueString(); len(password) > 0 {
-		_, err := r.client.UpdateServicePassword(s.Id, ServicePasswordUpdateFromPlainPassword(password))
+		err := withRetry(ctx, func() error {
+			var err error
+			s, err = r.client.UpdateServicePrivateEndpoints(s.Id, ServicePrivateEndpointsUpdate{Add: ids})
+			return err
+		})
 		if err != nil {
 			resp.Diagnostics.AddError(
-				"Error setting service password",
-				"Could not set service password after creation, unexpected error: "+err.Error(),
+				"Error attaching private endpoints",
+				"Could not attach private endpoints to service, unexpected error: "+err.Error(),
 			)
 			return
 		}
@@ -261,6 +559,9 @@ func (r *serviceResource) Create(ctx context.Context, req resource.CreateRequest
 
 	plan.Endpoints, _ = types.ListValue(endpointObjectType, values)
 
+	plan.PrivateEndpointIds, _ = types.ListValueFrom(ctx, types.StringType, s.PrivateEndpointIds)
+	plan.PrivateEndpointConfig = privateEndpointConfigFromService(s)
+
 	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 
 	// Set state to fully populated data
@@ -281,7 +582,12 @@ func (r *serviceResource) Read(ctx context.Context, req resource.ReadRequest, re
 	}
 
 	// Get refreshed service value from ClickHouse OpenAPI
-	service, err := r.client.GetService(state.ID.ValueString())
+	var service *Service
+	err := withRetry(ctx, func() error {
+		var err error
+		service, err = r.client.GetService(state.ID.ValueString())
+		return err
+	})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading ClickHouse Service",
@@ -311,6 +617,9 @@ func (r *serviceResource) Read(ctx context.Context, req resource.ReadRequest, re
 	}
 	state.Endpoints, _ = types.ListValue(endpointObjectType, values)
 
+	state.PrivateEndpointIds, _ = types.ListValueFrom(ctx, types.StringType, service.PrivateEndpointIds)
+	state.PrivateEndpointConfig = privateEndpointConfigFromService(service)
+
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -327,33 +636,19 @@ func (r *serviceResource) Update(ctx context.Context, req resource.UpdateRequest
 	diags = req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 
-	if plan.CloudProvider != state.CloudProvider {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("cloud_provider"),
-			"Invalid Update",
-			"ClickHouse does not support changing service cloud providers",
-		)
-	}
-
-	if plan.Region != state.Region {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("region"),
-			"Invalid Update",
-			"ClickHouse does not support changing service regions",
-		)
-	}
-
-	if plan.Tier != state.Tier {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("tier"),
-			"Invalid Update",
-			"ClickHouse does not support changing service tiers",
-		)
+	// cloud_provider and region always RequiresReplace; only a 'development' to
+	// 'production' tier change can reach Update without a plan-time replace.
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultServiceOperationTimeout)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
 
 	// Generate API request body from plan
 	serviceId := state.ID.ValueString()
@@ -367,6 +662,12 @@ func (r *serviceResource) Update(ctx context.Context, req resource.UpdateRequest
 		service.Name = plan.Name.ValueString()
 		serviceChange = true
 	}
+	if plan.Tier != state.Tier {
+		// Reaching Update with a tier change at all means tierRequiresReplace allowed
+		// it, i.e. this is a 'development' to 'production' upgrade.
+		service.Tier = plan.Tier.ValueString()
+		serviceChange = true
+	}
 	if !equal(plan.IpAccessList, state.IpAccessList) {
 		serviceChange = true
 		ipAccessListRawOld := state.IpAccessList
@@ -406,8 +707,11 @@ func (r *serviceResource) Update(ctx context.Context, req resource.UpdateRequest
 	// Update existing order
 	var s *Service
 	if serviceChange {
-		var err error
-		s, err = r.client.UpdateService(serviceId, service)
+		err := withRetry(ctx, func() error {
+			var err error
+			s, err = r.client.UpdateService(serviceId, service)
+			return err
+		})
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error Updating ClickHouse Service",
@@ -442,8 +746,11 @@ func (r *serviceResource) Update(ctx context.Context, req resource.UpdateRequest
 	}
 
 	if scalingChange {
-		var err error
-		s, err = r.client.UpdateServiceScaling(serviceId, serviceScaling)
+		err := withRetry(ctx, func() error {
+			var err error
+			s, err = r.client.UpdateServiceScaling(serviceId, serviceScaling)
+			return err
+		})
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error Updating ClickHouse Service Scaling",
@@ -453,10 +760,63 @@ func (r *serviceResource) Update(ctx context.Context, req resource.UpdateRequest
 		}
 	}
 
+	privateEndpointChange := !plan.PrivateEndpointIds.Equal(state.PrivateEndpointIds)
+	if privateEndpointChange {
+		var oldIds, newIds []string
+		diags = state.PrivateEndpointIds.ElementsAs(ctx, &oldIds, false)
+		resp.Diagnostics.Append(diags...)
+		diags = plan.PrivateEndpointIds.ElementsAs(ctx, &newIds, false)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		add, remove := diffArrays(oldIds, newIds, func(id string) string { return id })
+
+		err := withRetry(ctx, func() error {
+			var err error
+			s, err = r.client.UpdateServicePrivateEndpoints(serviceId, ServicePrivateEndpointsUpdate{
+				Add:    add,
+				Remove: remove,
+			})
+			return err
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating ClickHouse Service Private Endpoints",
+				"Could not update service private endpoints, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	if serviceChange || scalingChange || privateEndpointChange {
+		var err error
+		s, err = waitForServiceState(ctx, r.client, serviceId, "provisioning", "updating")
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Timed out waiting for service update",
+				fmt.Sprintf("Service %s did not finish updating within the configured update timeout: %s", serviceId, err.Error()),
+			)
+			return
+		}
+	}
+
 	password := state.Password.String()
 	if plan.Password != state.Password {
 		password = plan.Password.ValueString()
-		res, err := r.client.UpdateServicePassword(serviceId, ServicePasswordUpdateFromPlainPassword(password))
+		err := withRetry(ctx, func() error {
+			res, err := r.client.UpdateServicePassword(serviceId, ServicePasswordUpdateFromPlainPassword(password))
+			if err != nil {
+				return err
+			}
+
+			// empty password provided, ClickHouse Cloud return a new generated password
+			if len(res.Password) > 0 {
+				password = res.Password
+			}
+			return nil
+		})
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error Updating ClickHouse Service Password",
@@ -464,11 +824,6 @@ func (r *serviceResource) Update(ctx context.Context, req resource.UpdateRequest
 			)
 			return
 		}
-
-		// empty password provided, ClickHouse Cloud return a new generated password
-		if len(res.Password) > 0 {
-			password = res.Password
-		}
 	}
 
 	// Update resource state with updated items and timestamp
@@ -488,6 +843,8 @@ func (r *serviceResource) Update(ctx context.Context, req resource.UpdateRequest
 			Description: types.StringValue(ipAccess.Description),
 		}
 	}
+	plan.PrivateEndpointIds, _ = types.ListValueFrom(ctx, types.StringType, s.PrivateEndpointIds)
+	plan.PrivateEndpointConfig = privateEndpointConfigFromService(s)
 	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 
 	diags = resp.State.Set(ctx, plan)
@@ -507,8 +864,19 @@ func (r *serviceResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultServiceOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Delete existing order
-	_, err := r.client.DeleteService(state.ID.ValueString())
+	err := withRetry(ctx, func() error {
+		_, err := r.client.DeleteService(state.ID.ValueString())
+		return err
+	})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Deleting ClickHouse Service",
@@ -516,6 +884,43 @@ func (r *serviceResource) Delete(ctx context.Context, req resource.DeleteRequest
 		)
 		return
 	}
+
+	for {
+		var s *Service
+		err := withRetry(ctx, func() error {
+			var err error
+			s, err = r.client.GetService(state.ID.ValueString())
+			return err
+		})
+		if err != nil {
+			// withRetry also returns a non-nil error if ctx is done while it's
+			// sleeping between attempts; that's a genuine delete timeout, not
+			// confirmation that the service is gone, and must not be swallowed.
+			if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+				resp.Diagnostics.AddError(
+					"Timed out waiting for service deletion",
+					fmt.Sprintf("Service %s did not finish deleting within the configured delete timeout: %s", state.ID.ValueString(), err.Error()),
+				)
+				return
+			}
+
+			// Otherwise the service is gone once GetService starts returning a terminal error.
+			break
+		}
+		if s.State != "deprovisioning" {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			resp.Diagnostics.AddError(
+				"Timed out waiting for service deletion",
+				fmt.Sprintf("Service %s did not finish deleting within the configured delete timeout: %s", state.ID.ValueString(), ctx.Err()),
+			)
+			return
+		case <-time.After(servicePollInterval):
+		}
+	}
 }
 
 func (r *serviceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -0,0 +1,292 @@
+package clickhouse
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                     = &serviceDataSource{}
+	_ datasource.DataSourceWithConfigure        = &serviceDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &serviceDataSource{}
+)
+
+// NewServiceDataSource is a helper function to simplify the provider implementation.
+func NewServiceDataSource() datasource.DataSource {
+	return &serviceDataSource{}
+}
+
+// serviceDataSource reads an existing ClickHouse Cloud service, managed by this provider,
+// another Terraform workspace, or created directly through the console.
+type serviceDataSource struct {
+	client *Client
+}
+
+type serviceDataSourceModel struct {
+	ID                 types.String    `tfsdk:"id"`
+	Name               types.String    `tfsdk:"name"`
+	Region             types.String    `tfsdk:"region"`
+	CloudProvider      types.String    `tfsdk:"cloud_provider"`
+	Tier               types.String    `tfsdk:"tier"`
+	IdleScaling        types.Bool      `tfsdk:"idle_scaling"`
+	IpAccessList       []IpAccessModel `tfsdk:"ip_access"`
+	Endpoints          types.List      `tfsdk:"endpoints"`
+	MinTotalMemoryGb   types.Int64     `tfsdk:"min_total_memory_gb"`
+	MaxTotalMemoryGb   types.Int64     `tfsdk:"max_total_memory_gb"`
+	IdleTimeoutMinutes types.Int64     `tfsdk:"idle_timeout_minutes"`
+}
+
+func (d *serviceDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service"
+}
+
+func (d *serviceDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads an existing ClickHouse Cloud service, looked up either by `id` or by " +
+			"`name`, `region` and `cloud_provider` together. Useful for referencing a service managed " +
+			"outside of this Terraform workspace, e.g. as the target of a `clickhouse_database`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the service. Either this or `name`, `region` and `cloud_provider` together must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "User defined identifier for the service.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"region": schema.StringAttribute{
+				Description: "Region within the cloud provider in which the service is deployed in.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"cloud_provider": schema.StringAttribute{
+				Description: "Cloud provider ('aws', 'gcp' or 'azure') in which the service is deployed in.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"tier": schema.StringAttribute{
+				Description: "Tier of the service: 'development', 'production'.",
+				Computed:    true,
+			},
+			"idle_scaling": schema.BoolAttribute{
+				Description: "Whether the service is allowed to scale down to zero when idle.",
+				Computed:    true,
+			},
+			"ip_access": schema.ListNestedAttribute{
+				Description: "List of IP addresses allowed to access the service.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"source": schema.StringAttribute{
+							Description: "IP address allowed to access the service.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Description of the IP address.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"endpoints": schema.ListNestedAttribute{
+				Description: "List of public endpoints.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"protocol": schema.StringAttribute{
+							Description: "Endpoint protocol: https or nativesecure",
+							Computed:    true,
+						},
+						"host": schema.StringAttribute{
+							Description: "Endpoint host.",
+							Computed:    true,
+						},
+						"port": schema.Int64Attribute{
+							Description: "Endpoint port.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"min_total_memory_gb": schema.Int64Attribute{
+				Description: "Minimum total memory of all workers during auto-scaling in Gb.",
+				Computed:    true,
+			},
+			"max_total_memory_gb": schema.Int64Attribute{
+				Description: "Maximum total memory of all workers during auto-scaling in Gb.",
+				Computed:    true,
+			},
+			"idle_timeout_minutes": schema.Int64Attribute{
+				Description: "Idling timeout (in minutes).",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *serviceDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*Client)
+}
+
+// ConfigValidators enforces that a service is looked up either by id, or by name,
+// region and cloud_provider together, not a partial mix of the two.
+func (d *serviceDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		validatorServiceLookupKey{},
+	}
+}
+
+// validatorServiceLookupKey enforces that a clickhouse_service data source is configured
+// with exactly one lookup strategy: id, or the (name, region, cloud_provider) triple.
+type validatorServiceLookupKey struct{}
+
+func (v validatorServiceLookupKey) Description(_ context.Context) string {
+	return "exactly one of id, or name+region+cloud_provider together, must be set"
+}
+
+func (v validatorServiceLookupKey) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v validatorServiceLookupKey) ValidateDataSource(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config serviceDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hasID := !config.ID.IsNull() && config.ID.ValueString() != ""
+	hasName := !config.Name.IsNull() && config.Name.ValueString() != ""
+	hasRegion := !config.Region.IsNull() && config.Region.ValueString() != ""
+	hasCloudProvider := !config.CloudProvider.IsNull() && config.CloudProvider.ValueString() != ""
+	hasLookupTriple := hasName && hasRegion && hasCloudProvider
+
+	if hasID == hasLookupTriple {
+		resp.Diagnostics.AddError(
+			"Invalid configuration",
+			"Exactly one of 'id' or 'name', 'region' and 'cloud_provider' together must be set on a clickhouse_service data source.",
+		)
+		return
+	}
+
+	if !hasID && (hasName || hasRegion || hasCloudProvider) && !hasLookupTriple {
+		resp.Diagnostics.AddError(
+			"Invalid configuration",
+			"'name', 'region' and 'cloud_provider' must all be set together when 'id' is not used.",
+		)
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *serviceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config serviceDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var service *Service
+	if id := config.ID.ValueString(); id != "" {
+		err := withRetry(ctx, func() error {
+			var err error
+			service, err = d.client.GetService(id)
+			return err
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading service",
+				"Could not read ClickHouse service id "+id+": "+err.Error(),
+			)
+			return
+		}
+	} else {
+		name := config.Name.ValueString()
+		region := config.Region.ValueString()
+		provider := config.CloudProvider.ValueString()
+
+		var services []Service
+		err := withRetry(ctx, func() error {
+			var err error
+			services, err = d.client.ListServices()
+			return err
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error listing services",
+				"Could not list ClickHouse services: "+err.Error(),
+			)
+			return
+		}
+
+		var matches []Service
+		for i := range services {
+			if services[i].Name == name && services[i].Region == region && services[i].Provider == provider {
+				matches = append(matches, services[i])
+			}
+		}
+
+		if len(matches) == 0 {
+			resp.Diagnostics.AddError(
+				"Service not found",
+				fmt.Sprintf("No service named %q was found in region %q on %q.", name, region, provider),
+			)
+			return
+		}
+		if len(matches) > 1 {
+			resp.Diagnostics.AddError(
+				"Ambiguous service lookup",
+				fmt.Sprintf("Found %d services named %q in region %q on %q; use 'id' to disambiguate.", len(matches), name, region, provider),
+			)
+			return
+		}
+
+		service = &matches[0]
+	}
+
+	config.ID = types.StringValue(service.Id)
+	config.Name = types.StringValue(service.Name)
+	config.Region = types.StringValue(service.Region)
+	config.CloudProvider = types.StringValue(service.Provider)
+	config.Tier = types.StringValue(service.Tier)
+	config.IdleScaling = types.BoolValue(service.IdleScaling)
+	config.MinTotalMemoryGb = types.Int64Value(int64(service.MinTotalMemoryGb))
+	config.MaxTotalMemoryGb = types.Int64Value(int64(service.MaxTotalMemoryGb))
+	config.IdleTimeoutMinutes = types.Int64Value(int64(service.IdleTimeoutMinutes))
+
+	config.IpAccessList = nil
+	for _, item := range service.IpAccessList {
+		config.IpAccessList = append(config.IpAccessList, IpAccessModel{
+			Source:      types.StringValue(item.Source),
+			Description: types.StringValue(item.Description),
+		})
+	}
+
+	var values []attr.Value
+	for _, endpoint := range service.Endpoints {
+		obj, _ := types.ObjectValue(endpointObjectType.AttrTypes, map[string]attr.Value{
+			"protocol": types.StringValue(endpoint.Protocol),
+			"host":     types.StringValue(endpoint.Host),
+			"port":     types.Int64Value(int64(endpoint.Port)),
+		})
+
+		values = append(values, obj)
+	}
+	config.Endpoints, _ = types.ListValue(endpointObjectType, values)
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}